@@ -0,0 +1,35 @@
+package vips
+
+/*
+#include "vips_wrapper.h"
+#include <stdlib.h>
+
+extern EXIFFieldList extract_exif(VImageHandle handle);
+extern void free_exif_field_list(EXIFFieldList fields);
+*/
+import "C"
+import "unsafe"
+
+// ExtractEXIF surfaces the raw EXIF fields carried by the image (as stored
+// by libvips under the "exif-ifdN-..." metadata keys), so callers can
+// selectively preserve fields like GPS or camera model before stripping
+// metadata on save.
+func (img *Image) ExtractEXIF() (map[string]string, error) {
+	if img.handle == nil {
+		return nil, VipsInvalidHandle.Error()
+	}
+
+	cFields := C.extract_exif(img.handle)
+	defer C.free_exif_field_list(cFields)
+
+	count := int(cFields.count)
+	entries := (*[1 << 20]C.EXIFField)(unsafe.Pointer(cFields.entries))[:count:count]
+
+	fields := make(map[string]string, count)
+	for _, e := range entries {
+		key := C.GoString(&e.key[0])
+		value := C.GoString(&e.value[0])
+		fields[key] = value
+	}
+	return fields, nil
+}
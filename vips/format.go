@@ -0,0 +1,213 @@
+package vips
+
+/*
+#include "vips_wrapper.h"
+#include <stdlib.h>
+
+extern ImageBuffer encode_to_webp(VImageHandle handle, ImageEncodeWebPOptions options);
+extern ImageBuffer encode_to_avif(VImageHandle handle, ImageEncodeAVIFOptions options);
+extern ImageBuffer encode_to_tiff(VImageHandle handle, ImageEncodeTIFFOptions options);
+*/
+import "C"
+import (
+	"bytes"
+	"fmt"
+	"unsafe"
+)
+
+// ImageFormat identifies an image container format.
+type ImageFormat int
+
+const (
+	FormatUnknown ImageFormat = iota
+	FormatJPEG
+	FormatPNG
+	FormatWebP
+	FormatAVIF
+	FormatTIFF
+	FormatGIF
+)
+
+// String returns the human-readable name of the format.
+func (f ImageFormat) String() string {
+	switch f {
+	case FormatJPEG:
+		return "jpeg"
+	case FormatPNG:
+		return "png"
+	case FormatWebP:
+		return "webp"
+	case FormatAVIF:
+		return "avif"
+	case FormatTIFF:
+		return "tiff"
+	case FormatGIF:
+		return "gif"
+	default:
+		return "unknown"
+	}
+}
+
+// DetectFormat inspects the leading magic bytes of data and returns the
+// format they identify, or FormatUnknown if none match.
+func DetectFormat(data []byte) ImageFormat {
+	switch {
+	case len(data) >= 2 && data[0] == 0xFF && data[1] == 0xD8:
+		return FormatJPEG
+	case len(data) >= 8 && bytes.Equal(data[:8], []byte{0x89, 0x50, 0x4E, 0x47, 0x0D, 0x0A, 0x1A, 0x0A}):
+		return FormatPNG
+	case len(data) >= 12 && bytes.Equal(data[:4], []byte("RIFF")) && bytes.Equal(data[8:12], []byte("WEBP")):
+		return FormatWebP
+	case len(data) >= 12 && bytes.Equal(data[4:8], []byte("ftyp")) &&
+		(bytes.Equal(data[8:12], []byte("avif")) || bytes.Equal(data[8:12], []byte("heic"))):
+		return FormatAVIF
+	case len(data) >= 4 && (bytes.Equal(data[:4], []byte{0x49, 0x49, 0x2A, 0x00}) || bytes.Equal(data[:4], []byte{0x4D, 0x4D, 0x00, 0x2A})):
+		return FormatTIFF
+	case len(data) >= 4 && bytes.Equal(data[:4], []byte("GIF8")):
+		return FormatGIF
+	default:
+		return FormatUnknown
+	}
+}
+
+// ImageEncodeWebPOptions defines options for WebP encoding.
+type ImageEncodeWebPOptions struct {
+	Quality         int  // 1-100
+	Lossless        bool
+	NearLossless    bool
+	ReductionEffort int  // 0-6, higher is slower but smaller
+	StripMetadata   bool // Drop EXIF/ICC/XMP on save
+}
+
+// ImageEncodeAVIFOptions defines options for AVIF encoding.
+type ImageEncodeAVIFOptions struct {
+	Quality       int  // 1-100
+	Speed         int  // 0-9, higher is faster but larger
+	StripMetadata bool // Drop EXIF/ICC/XMP on save
+}
+
+// ImageEncodeTIFFOptions defines options for TIFF encoding.
+type ImageEncodeTIFFOptions struct {
+	Compression   int  // 0-9
+	Quality       int  // 1-100, only used for JPEG-compressed TIFF
+	StripMetadata bool // Drop EXIF/ICC/XMP on save
+}
+
+// EncodeOptions carries the union of per-format encode settings consumed by
+// Encode. Fields that don't apply to the requested format are ignored.
+type EncodeOptions struct {
+	Quality         int
+	Compression     int
+	Interlace       bool
+	Lossless        bool
+	NearLossless    bool
+	ReductionEffort int
+	Speed           int
+	StripMetadata   bool
+}
+
+// EncodeToWebP encodes the image to WebP format and returns the encoded data.
+func (img *Image) EncodeToWebP(options *ImageEncodeWebPOptions) ([]byte, error) {
+	if img.handle == nil {
+		return nil, VipsInvalidHandle.Error()
+	}
+
+	cOptions := C.ImageEncodeWebPOptions{
+		quality:          C.int(options.Quality),
+		lossless:         C.int(0),
+		near_lossless:    C.int(0),
+		reduction_effort: C.int(options.ReductionEffort),
+		strip:            C.int(0),
+	}
+	if options.Lossless {
+		cOptions.lossless = C.int(1)
+	}
+	if options.NearLossless {
+		cOptions.near_lossless = C.int(1)
+	}
+	if options.StripMetadata {
+		cOptions.strip = C.int(1)
+	}
+
+	cBuffer := C.encode_to_webp(img.handle, cOptions)
+	if cBuffer.data == nil {
+		return nil, fmt.Errorf("failed to encode image to WebP: check logs for VIPS errors")
+	}
+	defer C.free_image_buffer(cBuffer)
+
+	return C.GoBytes(unsafe.Pointer(cBuffer.data), C.int(cBuffer.size)), nil
+}
+
+// EncodeToAVIF encodes the image to AVIF format and returns the encoded data.
+func (img *Image) EncodeToAVIF(options *ImageEncodeAVIFOptions) ([]byte, error) {
+	if img.handle == nil {
+		return nil, VipsInvalidHandle.Error()
+	}
+
+	cOptions := C.ImageEncodeAVIFOptions{
+		quality: C.int(options.Quality),
+		speed:   C.int(options.Speed),
+		strip:   C.int(0),
+	}
+	if options.StripMetadata {
+		cOptions.strip = C.int(1)
+	}
+
+	cBuffer := C.encode_to_avif(img.handle, cOptions)
+	if cBuffer.data == nil {
+		return nil, fmt.Errorf("failed to encode image to AVIF: check logs for VIPS errors")
+	}
+	defer C.free_image_buffer(cBuffer)
+
+	return C.GoBytes(unsafe.Pointer(cBuffer.data), C.int(cBuffer.size)), nil
+}
+
+// EncodeToTIFF encodes the image to TIFF format and returns the encoded data.
+func (img *Image) EncodeToTIFF(options *ImageEncodeTIFFOptions) ([]byte, error) {
+	if img.handle == nil {
+		return nil, VipsInvalidHandle.Error()
+	}
+
+	cOptions := C.ImageEncodeTIFFOptions{
+		compression: C.int(options.Compression),
+		quality:     C.int(options.Quality),
+		strip:       C.int(0),
+	}
+	if options.StripMetadata {
+		cOptions.strip = C.int(1)
+	}
+
+	cBuffer := C.encode_to_tiff(img.handle, cOptions)
+	if cBuffer.data == nil {
+		return nil, fmt.Errorf("failed to encode image to TIFF: check logs for VIPS errors")
+	}
+	defer C.free_image_buffer(cBuffer)
+
+	return C.GoBytes(unsafe.Pointer(cBuffer.data), C.int(cBuffer.size)), nil
+}
+
+// Encode dispatches to the appropriate per-format encoder based on format,
+// letting callers round-trip a Pipeline result without a type switch of
+// their own.
+func (img *Image) Encode(format ImageFormat, opts EncodeOptions) ([]byte, error) {
+	switch format {
+	case FormatJPEG:
+		return img.EncodeToJPEG(&ImageEncodeJPEGOptions{Quality: opts.Quality, Interlace: opts.Interlace, StripMetadata: opts.StripMetadata})
+	case FormatPNG:
+		return img.EncodeToPNG(&ImageEncodePNGOptions{Compression: opts.Compression, Interlace: opts.Interlace, StripMetadata: opts.StripMetadata})
+	case FormatWebP:
+		return img.EncodeToWebP(&ImageEncodeWebPOptions{
+			Quality:         opts.Quality,
+			Lossless:        opts.Lossless,
+			NearLossless:    opts.NearLossless,
+			ReductionEffort: opts.ReductionEffort,
+			StripMetadata:   opts.StripMetadata,
+		})
+	case FormatAVIF:
+		return img.EncodeToAVIF(&ImageEncodeAVIFOptions{Quality: opts.Quality, Speed: opts.Speed, StripMetadata: opts.StripMetadata})
+	case FormatTIFF:
+		return img.EncodeToTIFF(&ImageEncodeTIFFOptions{Compression: opts.Compression, Quality: opts.Quality, StripMetadata: opts.StripMetadata})
+	default:
+		return nil, fmt.Errorf("vips: unsupported encode format: %s", format)
+	}
+}
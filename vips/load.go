@@ -0,0 +1,205 @@
+package vips
+
+/*
+#include "vips_wrapper.h"
+#include <stdlib.h>
+
+extern ImageDimensions peek_dimensions_from_bytes(const unsigned char* data, size_t size);
+extern ImageDimensions peek_dimensions(const char* input_path);
+extern VImageHandle load_image_from_bytes_with_shrink(const unsigned char* data, size_t size, int shrink);
+extern VImageHandle load_image_with_shrink(const char* input_path, int shrink);
+*/
+import "C"
+import (
+	"errors"
+	"os"
+	"runtime"
+	"unsafe"
+)
+
+// LoadOptions controls shrink-on-load behaviour for LoadImageFromBytesWithOptions
+// and LoadImageWithOptions. Either Width/Height or Shrink may be set; if Shrink
+// is zero it is derived from the source dimensions and the requested Width/Height.
+type LoadOptions struct {
+	Width  int
+	Height int
+	Shrink int // explicit shrink factor; takes precedence over Width/Height
+}
+
+// jpegShrinkFactors are the only shrink values vips_jpegload_buffer accepts.
+var jpegShrinkFactors = []int{8, 4, 2, 1}
+
+// shrinkFactor returns the largest shrink factor that still leaves the
+// source dimension at or above the target, picking from allowed when it is
+// non-empty (JPEG, which only accepts 1/2/4/8) or any integer >= 1 otherwise
+// (WebP, which accepts any shrink on libvips 8.3+).
+func shrinkFactor(source, target int, allowed []int) int {
+	if target <= 0 || source <= target {
+		return 1
+	}
+	if len(allowed) > 0 {
+		best := 1
+		for _, f := range allowed {
+			if source/f >= target {
+				best = f
+				break
+			}
+		}
+		return best
+	}
+	f := source / target
+	if f < 1 {
+		f = 1
+	}
+	return f
+}
+
+// planShrink picks a shrink factor for each dimension that was requested and
+// returns the smaller of the two, since shrinking must not undershoot either
+// bound.
+func planShrink(meta ImageMeta, format ImageFormat, opts *LoadOptions) int {
+	if opts.Shrink > 0 {
+		return opts.Shrink
+	}
+
+	var allowed []int
+	if format == FormatJPEG {
+		allowed = jpegShrinkFactors
+	}
+
+	shrink := 0
+	if opts.Width > 0 {
+		shrink = shrinkFactor(meta.Width, opts.Width, allowed)
+	}
+	if opts.Height > 0 {
+		if hs := shrinkFactor(meta.Height, opts.Height, allowed); shrink == 0 || hs < shrink {
+			shrink = hs
+		}
+	}
+	if shrink == 0 {
+		shrink = 1
+	}
+	return shrink
+}
+
+// residualResize applies the aspect-preserving Resize towards the requested
+// Width/Height that remains after an integer shrink-on-load has gotten most
+// of the way there.
+func residualResize(img *Image, opts *LoadOptions) error {
+	if opts.Width == 0 && opts.Height == 0 {
+		return nil
+	}
+
+	meta, err := img.ExtractMetadata()
+	if err != nil {
+		return err
+	}
+	if meta.Width == opts.Width && (opts.Height == 0 || meta.Height == opts.Height) {
+		return nil
+	}
+
+	return img.Resize(&ImageResizeOptions{
+		Width:          opts.Width,
+		Height:         opts.Height,
+		MaintainAspect: true,
+	})
+}
+
+// LoadImageFromBytesWithOptions loads an image from a byte slice, shrinking
+// it during decode to approximately the requested Width/Height. For JPEG and
+// WebP sources this dispatches to vips_jpegload_buffer / vips_webpload_buffer
+// with the computed shrink factor, avoiding a full-resolution decode before
+// the subsequent Resize. Other formats fall back to a full-resolution load
+// followed by a single Resize.
+func LoadImageFromBytesWithOptions(data []byte, opts *LoadOptions) (*Image, error) {
+	if opts == nil {
+		return LoadImageFromBytes(data)
+	}
+	if len(data) == 0 {
+		return nil, errors.New("image data is empty")
+	}
+
+	cData := (*C.uchar)(unsafe.Pointer(&data[0]))
+	cSize := C.size_t(len(data))
+
+	format := DetectFormat(data)
+	if format != FormatJPEG && format != FormatWebP {
+		img, err := LoadImageFromBytes(data)
+		if err != nil {
+			return nil, err
+		}
+		if err := residualResize(img, opts); err != nil {
+			img.Free()
+			return nil, err
+		}
+		return img, nil
+	}
+
+	dims := C.peek_dimensions_from_bytes(cData, cSize)
+	meta := ImageMeta{Width: int(dims.width), Height: int(dims.height)}
+	shrink := planShrink(meta, format, opts)
+
+	handle := C.load_image_from_bytes_with_shrink(cData, cSize, C.int(shrink))
+	if handle == nil {
+		return nil, errors.New("failed to load image from bytes: check logs for VIPS errors")
+	}
+
+	img := &Image{handle: handle}
+	runtime.SetFinalizer(img, func(i *Image) {
+		C.free_vimage_handle(i.handle)
+	})
+
+	if err := residualResize(img, opts); err != nil {
+		img.Free()
+		return nil, err
+	}
+	return img, nil
+}
+
+// LoadImageWithOptions is the path-based equivalent of
+// LoadImageFromBytesWithOptions.
+func LoadImageWithOptions(inputPath string, opts *LoadOptions) (*Image, error) {
+	if opts == nil {
+		return LoadImage(inputPath)
+	}
+
+	cInputPath := C.CString(inputPath)
+	defer C.free(unsafe.Pointer(cInputPath))
+
+	data, err := os.ReadFile(inputPath)
+	if err != nil {
+		return nil, err
+	}
+	format := DetectFormat(data)
+	if format != FormatJPEG && format != FormatWebP {
+		img, err := LoadImage(inputPath)
+		if err != nil {
+			return nil, err
+		}
+		if err := residualResize(img, opts); err != nil {
+			img.Free()
+			return nil, err
+		}
+		return img, nil
+	}
+
+	dims := C.peek_dimensions(cInputPath)
+	meta := ImageMeta{Width: int(dims.width), Height: int(dims.height)}
+	shrink := planShrink(meta, format, opts)
+
+	handle := C.load_image_with_shrink(cInputPath, C.int(shrink))
+	if handle == nil {
+		return nil, errors.New("failed to load image: check logs for VIPS errors")
+	}
+
+	img := &Image{handle: handle}
+	runtime.SetFinalizer(img, func(i *Image) {
+		C.free_vimage_handle(i.handle)
+	})
+
+	if err := residualResize(img, opts); err != nil {
+		img.Free()
+		return nil, err
+	}
+	return img, nil
+}
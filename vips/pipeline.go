@@ -0,0 +1,160 @@
+package vips
+
+// Pipeline accumulates a sequence of in-place operations on an *Image,
+// deferring error handling to a single check at the terminal call instead of
+// one `if err != nil` per step. Each step runs on the image as soon as it is
+// called; steps are not queued until the terminal call. Once a step fails,
+// every later step is skipped.
+type Pipeline struct {
+	img *Image
+	err error
+}
+
+// Pipeline starts a chainable operation sequence on img.
+func (img *Image) Pipeline() *Pipeline {
+	return &Pipeline{img: img}
+}
+
+// Resize applies a Resize step.
+func (p *Pipeline) Resize(options *ImageResizeOptions) *Pipeline {
+	if p.err != nil {
+		return p
+	}
+	p.err = p.img.Resize(options)
+	return p
+}
+
+// Crop applies a Crop step.
+func (p *Pipeline) Crop(options *ImageCropOptions) *Pipeline {
+	if p.err != nil {
+		return p
+	}
+	p.err = p.img.Crop(options)
+	return p
+}
+
+// Rotate applies a Rotate step.
+func (p *Pipeline) Rotate(options *ImageRotateOptions) *Pipeline {
+	if p.err != nil {
+		return p
+	}
+	p.err = p.img.Rotate(options)
+	return p
+}
+
+// Watermark applies a Watermark step.
+func (p *Pipeline) Watermark(watermarkImg *Image, options *ImageWatermarkOptions) *Pipeline {
+	if p.err != nil {
+		return p
+	}
+	p.err = p.img.Watermark(watermarkImg, options)
+	return p
+}
+
+// Opacity applies a ChangeOpacity step.
+func (p *Pipeline) Opacity(options *ImageOpacityOptions) *Pipeline {
+	if p.err != nil {
+		return p
+	}
+	p.err = p.img.ChangeOpacity(options)
+	return p
+}
+
+// AutoOrient applies an AutoOrient step.
+func (p *Pipeline) AutoOrient() *Pipeline {
+	if p.err != nil {
+		return p
+	}
+	p.err = p.img.AutoOrient()
+	return p
+}
+
+// Sharpen applies a Sharpen step.
+func (p *Pipeline) Sharpen(options *ImageSharpenOptions) *Pipeline {
+	if p.err != nil {
+		return p
+	}
+	p.err = p.img.Sharpen(options)
+	return p
+}
+
+// Blur applies a Blur step.
+func (p *Pipeline) Blur(options *ImageBlurOptions) *Pipeline {
+	if p.err != nil {
+		return p
+	}
+	p.err = p.img.Blur(options)
+	return p
+}
+
+// Flip applies a Flip step.
+func (p *Pipeline) Flip() *Pipeline {
+	if p.err != nil {
+		return p
+	}
+	p.err = p.img.Flip()
+	return p
+}
+
+// Flop applies a Flop step.
+func (p *Pipeline) Flop() *Pipeline {
+	if p.err != nil {
+		return p
+	}
+	p.err = p.img.Flop()
+	return p
+}
+
+// Convert applies a Convert step.
+func (p *Pipeline) Convert(format ImageFormat) *Pipeline {
+	if p.err != nil {
+		return p
+	}
+	p.err = p.img.Convert(format)
+	return p
+}
+
+// Result returns the processed image and the first error encountered, if
+// any.
+func (p *Pipeline) Result() (*Image, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p.img, nil
+}
+
+// Bytes encodes the processed image to format, short-circuiting on the first
+// error encountered earlier in the chain.
+func (p *Pipeline) Bytes(format ImageFormat, opts EncodeOptions) ([]byte, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p.img.Encode(format, opts)
+}
+
+// JPEG encodes the processed image to JPEG, short-circuiting on the first
+// error encountered earlier in the chain.
+func (p *Pipeline) JPEG(opts *ImageEncodeJPEGOptions) ([]byte, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p.img.EncodeToJPEG(opts)
+}
+
+// PNG encodes the processed image to PNG, short-circuiting on the first
+// error encountered earlier in the chain.
+func (p *Pipeline) PNG(opts *ImageEncodePNGOptions) ([]byte, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p.img.EncodeToPNG(opts)
+}
+
+// WebP encodes the processed image to WebP, short-circuiting on the first
+// error encountered earlier in the chain.
+func (p *Pipeline) WebP(opts *ImageEncodeWebPOptions) ([]byte, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p.img.EncodeToWebP(opts)
+}
@@ -0,0 +1,130 @@
+package vips
+
+/*
+#include "vips_wrapper.h"
+#include <stdlib.h>
+
+extern VImageHandle load_image_from_raw(const unsigned char* data, size_t size, int width, int height, int channels);
+extern RawImageBuffer extract_raw_pixels(VImageHandle handle);
+extern void free_raw_image_buffer(RawImageBuffer buffer);
+*/
+import "C"
+import (
+	"errors"
+	"image"
+	"image/color"
+	"io"
+	"runtime"
+	"unsafe"
+)
+
+// NewImageFromReader reads all of r and loads the result, so *Image
+// composes with http.Request.Body, os.File, bytes.Buffer, and
+// multipart.File without a manual io.ReadAll + LoadImageFromBytes step.
+func NewImageFromReader(r io.Reader) (*Image, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return LoadImageFromBytes(data)
+}
+
+// WriteTo encodes the image to format and writes the result to w, returning
+// the number of bytes written.
+func (img *Image) WriteTo(w io.Writer, format ImageFormat, opts EncodeOptions) (int64, error) {
+	data, err := img.Encode(format, opts)
+	if err != nil {
+		return 0, err
+	}
+	n, err := w.Write(data)
+	return int64(n), err
+}
+
+// ToStdImage converts the image to a standard library image.Image, mapping
+// libvips' UCHAR bands to image.RGBA, image.NRGBA, or image.Gray depending
+// on channel count and alpha, so vipsgo results can flow into image/jpeg,
+// image/png, and other codecs that expect image.Image.
+func (img *Image) ToStdImage() (image.Image, error) {
+	if img.handle == nil {
+		return nil, VipsInvalidHandle.Error()
+	}
+
+	meta, err := img.ExtractMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	cBuffer := C.extract_raw_pixels(img.handle)
+	if cBuffer.data == nil {
+		return nil, errors.New("failed to extract pixels: check logs for VIPS errors")
+	}
+	defer C.free_raw_image_buffer(cBuffer)
+
+	pix := C.GoBytes(unsafe.Pointer(cBuffer.data), C.int(cBuffer.size))
+	rect := image.Rect(0, 0, meta.Width, meta.Height)
+
+	switch meta.Channels {
+	case 1:
+		return &image.Gray{Pix: pix, Stride: meta.Width, Rect: rect}, nil
+	case 3:
+		return rgbToStdImage(pix, meta), nil
+	case 4:
+		if meta.HasAlpha {
+			return &image.NRGBA{Pix: pix, Stride: meta.Width * 4, Rect: rect}, nil
+		}
+		return &image.RGBA{Pix: pix, Stride: meta.Width * 4, Rect: rect}, nil
+	default:
+		return nil, errors.New("vips: unsupported channel count for ToStdImage")
+	}
+}
+
+// rgbToStdImage expands packed 3-channel RGB pixels into an image.NRGBA,
+// since the standard library has no 3-channel image type.
+func rgbToStdImage(pix []byte, meta ImageMeta) image.Image {
+	out := image.NewNRGBA(image.Rect(0, 0, meta.Width, meta.Height))
+	for i := 0; i < meta.Width*meta.Height; i++ {
+		out.Pix[i*4+0] = pix[i*3+0]
+		out.Pix[i*4+1] = pix[i*3+1]
+		out.Pix[i*4+2] = pix[i*3+2]
+		out.Pix[i*4+3] = 0xff
+	}
+	return out
+}
+
+// NewImageFromStd converts a standard library image.Image into an *Image,
+// the inverse of ToStdImage, so codecs libvips doesn't natively support
+// (GIF, BMP) can feed into vipsgo via image/gif, image/bmp, etc.
+func NewImageFromStd(src image.Image) (*Image, error) {
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return nil, errors.New("vips: source image has zero dimension")
+	}
+
+	pix := make([]byte, width*height*4)
+	i := 0
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			// RGBA() returns alpha-premultiplied components; convert to
+			// NRGBA so partially transparent pixels aren't darkened.
+			c := color.NRGBAModel.Convert(src.At(x, y)).(color.NRGBA)
+			pix[i+0] = c.R
+			pix[i+1] = c.G
+			pix[i+2] = c.B
+			pix[i+3] = c.A
+			i += 4
+		}
+	}
+
+	cData := (*C.uchar)(unsafe.Pointer(&pix[0]))
+	handle := C.load_image_from_raw(cData, C.size_t(len(pix)), C.int(width), C.int(height), C.int(4))
+	if handle == nil {
+		return nil, errors.New("failed to load image from raw pixels: check logs for VIPS errors")
+	}
+
+	img := &Image{handle: handle}
+	runtime.SetFinalizer(img, func(i *Image) {
+		C.free_vimage_handle(i.handle)
+	})
+	return img, nil
+}
@@ -0,0 +1,192 @@
+package vips
+
+/*
+#include "vips_wrapper.h"
+#include <stdlib.h>
+
+extern VImageHandle clone_image(VImageHandle handle);
+extern VImageHandle embed_image(VImageHandle handle, int width, int height, int x, int y);
+*/
+import "C"
+import (
+	"fmt"
+	"math"
+	"runtime"
+)
+
+// ThumbnailMethod controls how a thumbnail's aspect ratio is reconciled with
+// its target box.
+type ThumbnailMethod int
+
+const (
+	// Crop scales to fill the box, then center-crops the excess.
+	Crop ThumbnailMethod = iota
+	// Scale fits within the box, preserving aspect ratio.
+	Scale
+	// Fit letterboxes the image to exactly match the box.
+	Fit
+)
+
+// ThumbnailSpec describes a single output of a Thumbnails call.
+type ThumbnailSpec struct {
+	Name    string
+	Width   int
+	Height  int
+	Method  ThumbnailMethod
+	Format  ImageFormat
+	Quality int
+}
+
+// Clone returns an independent copy of the image, backed by vips_image_copy,
+// so derived operations (e.g. a thumbnail set) don't mutate the source.
+func (img *Image) Clone() (*Image, error) {
+	if img.handle == nil {
+		return nil, VipsInvalidHandle.Error()
+	}
+
+	handle := C.clone_image(img.handle)
+	if handle == nil {
+		return nil, fmt.Errorf("failed to clone image: check logs for VIPS errors")
+	}
+
+	clone := &Image{handle: handle}
+	runtime.SetFinalizer(clone, func(i *Image) {
+		C.free_vimage_handle(i.handle)
+	})
+	return clone, nil
+}
+
+// embed pads the image onto a width x height canvas with the image placed
+// at (x, y), backed by vips_embed. It's the letterbox step of a Fit
+// thumbnail.
+func (img *Image) embed(width, height, x, y int) error {
+	if img.handle == nil {
+		return VipsInvalidHandle.Error()
+	}
+
+	handle := C.embed_image(img.handle, C.int(width), C.int(height), C.int(x), C.int(y))
+	if handle == nil {
+		return fmt.Errorf("failed to embed image: check logs for VIPS errors")
+	}
+
+	C.free_vimage_handle(img.handle)
+	img.handle = handle
+	return nil
+}
+
+// Thumbnails snapshots the image once and derives one output per spec from a
+// cheap Clone, so a single load produces many sizes without re-decoding.
+// Outputs are keyed by spec.Name.
+func (img *Image) Thumbnails(specs []ThumbnailSpec) (map[string][]byte, error) {
+	if img.handle == nil {
+		return nil, VipsInvalidHandle.Error()
+	}
+
+	out := make(map[string][]byte, len(specs))
+	for _, spec := range specs {
+		data, err := img.thumbnail(spec)
+		if err != nil {
+			return nil, fmt.Errorf("thumbnail %q: %w", spec.Name, err)
+		}
+		out[spec.Name] = data
+	}
+	return out, nil
+}
+
+func (img *Image) thumbnail(spec ThumbnailSpec) ([]byte, error) {
+	clone, err := img.Clone()
+	if err != nil {
+		return nil, err
+	}
+	defer clone.Free()
+
+	meta, err := clone.ExtractMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	switch spec.Method {
+	case Crop:
+		if err := fillResize(clone, meta, spec.Width, spec.Height); err != nil {
+			return nil, err
+		}
+		meta, err = clone.ExtractMetadata()
+		if err != nil {
+			return nil, err
+		}
+		if err := clone.Crop(&ImageCropOptions{
+			X:      maxInt(0, (meta.Width-spec.Width)/2),
+			Y:      maxInt(0, (meta.Height-spec.Height)/2),
+			Width:  minInt(spec.Width, meta.Width),
+			Height: minInt(spec.Height, meta.Height),
+		}); err != nil {
+			return nil, err
+		}
+	case Scale:
+		if err := clone.Resize(&ImageResizeOptions{
+			Width:          spec.Width,
+			Height:         spec.Height,
+			MaintainAspect: true,
+		}); err != nil {
+			return nil, err
+		}
+	case Fit:
+		if err := clone.Resize(&ImageResizeOptions{
+			Width:          spec.Width,
+			Height:         spec.Height,
+			MaintainAspect: true,
+		}); err != nil {
+			return nil, err
+		}
+		meta, err = clone.ExtractMetadata()
+		if err != nil {
+			return nil, err
+		}
+		if err := clone.embed(
+			spec.Width, spec.Height,
+			maxInt(0, (spec.Width-meta.Width)/2),
+			maxInt(0, (spec.Height-meta.Height)/2),
+		); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("vips: unknown thumbnail method: %d", spec.Method)
+	}
+
+	return clone.Encode(spec.Format, EncodeOptions{Quality: spec.Quality})
+}
+
+// fillResize scales clone so it fully covers a width x height box, the first
+// step of a Crop thumbnail.
+func fillResize(clone *Image, meta ImageMeta, width, height int) error {
+	if meta.Width == 0 || meta.Height == 0 {
+		return fmt.Errorf("vips: image has zero dimension")
+	}
+
+	scaleX := float64(width) / float64(meta.Width)
+	scaleY := float64(height) / float64(meta.Height)
+	scale := scaleX
+	if scaleY > scale {
+		scale = scaleY
+	}
+
+	return clone.Resize(&ImageResizeOptions{
+		Width:          int(math.Ceil(float64(meta.Width) * scale)),
+		Height:         int(math.Ceil(float64(meta.Height) * scale)),
+		MaintainAspect: false,
+	})
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
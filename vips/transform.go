@@ -0,0 +1,102 @@
+package vips
+
+/*
+#include "vips_wrapper.h"
+#include <stdlib.h>
+
+extern ImageStatus sharpen_image(VImageHandle handle, ImageSharpenOptions options);
+extern ImageStatus blur_image(VImageHandle handle, ImageBlurOptions options);
+extern ImageStatus flip_image(VImageHandle handle);
+extern ImageStatus flop_image(VImageHandle handle);
+extern ImageStatus convert_image(VImageHandle handle, int format);
+extern ImageStatus auto_orient_image(VImageHandle handle);
+*/
+import "C"
+
+// ImageSharpenOptions defines options for sharpening an image.
+type ImageSharpenOptions struct {
+	Sigma float64 // radius of the Gaussian mask
+	X1    float64 // flat/jaggy threshold
+	Y2    float64 // maximum brightening
+	Y3    float64 // maximum darkening
+}
+
+// ImageBlurOptions defines options for Gaussian-blurring an image.
+type ImageBlurOptions struct {
+	Sigma float64
+}
+
+// Sharpen applies an unsharp mask to the image.
+func (img *Image) Sharpen(options *ImageSharpenOptions) error {
+	if img.handle == nil {
+		return VipsInvalidHandle.Error()
+	}
+
+	cOptions := C.ImageSharpenOptions{
+		sigma: C.double(options.Sigma),
+		x1:    C.double(options.X1),
+		y2:    C.double(options.Y2),
+		y3:    C.double(options.Y3),
+	}
+
+	status := ImageStatus(C.sharpen_image(img.handle, cOptions))
+	return status.Error()
+}
+
+// Blur applies a Gaussian blur to the image.
+func (img *Image) Blur(options *ImageBlurOptions) error {
+	if img.handle == nil {
+		return VipsInvalidHandle.Error()
+	}
+
+	cOptions := C.ImageBlurOptions{
+		sigma: C.double(options.Sigma),
+	}
+
+	status := ImageStatus(C.blur_image(img.handle, cOptions))
+	return status.Error()
+}
+
+// Flip mirrors the image vertically (top to bottom).
+func (img *Image) Flip() error {
+	if img.handle == nil {
+		return VipsInvalidHandle.Error()
+	}
+
+	status := ImageStatus(C.flip_image(img.handle))
+	return status.Error()
+}
+
+// Flop mirrors the image horizontally (left to right).
+func (img *Image) Flop() error {
+	if img.handle == nil {
+		return VipsInvalidHandle.Error()
+	}
+
+	status := ImageStatus(C.flop_image(img.handle))
+	return status.Error()
+}
+
+// AutoOrient applies the rotation/flip implied by the image's EXIF
+// orientation tag and resets the tag to 1 (normal), so that subsequent
+// geometric operations like Crop operate on the visually upright image.
+func (img *Image) AutoOrient() error {
+	if img.handle == nil {
+		return VipsInvalidHandle.Error()
+	}
+
+	status := ImageStatus(C.auto_orient_image(img.handle))
+	return status.Error()
+}
+
+// Convert prepares the image for encoding as format, applying any
+// format-specific adjustments libvips requires before save (e.g. flattening
+// alpha for formats that don't support it).
+func (img *Image) Convert(format ImageFormat) error {
+	if img.handle == nil {
+		return VipsInvalidHandle.Error()
+	}
+
+	status := ImageStatus(C.convert_image(img.handle, C.int(format)))
+	return status.Error()
+}
@@ -108,26 +108,30 @@ type ImageOpacityOptions struct {
 
 // ImageMeta contains metadata extracted from an image.
 type ImageMeta struct {
-	Width      int
-	Height     int
-	Channels   int
-	Format     string
-	Colorspace string
-	DensityX   float64
-	DensityY   float64
-	FileSize   int // This will be 0 as per C implementation, usually after encoding
+	Width       int
+	Height      int
+	Channels    int
+	Format      string
+	Colorspace  string
+	DensityX    float64
+	DensityY    float64
+	FileSize    int  // This will be 0 as per C implementation, usually after encoding
+	Orientation int  // EXIF orientation tag (1-8), 1 if absent
+	HasAlpha    bool
 }
 
 // ImageEncodeJPEGOptions defines options for JPEG encoding.
 type ImageEncodeJPEGOptions struct {
-	Quality   int  // 1-100
-	Interlace bool // Progressive JPEG
+	Quality       int  // 1-100
+	Interlace     bool // Progressive JPEG
+	StripMetadata bool // Drop EXIF/ICC/XMP on save
 }
 
 // ImageEncodePNGOptions defines options for PNG encoding.
 type ImageEncodePNGOptions struct {
-	Compression int  // 0-9
-	Interlace   bool // Adam7 interlacing
+	Compression   int  // 0-9
+	Interlace     bool // Adam7 interlacing
+	StripMetadata bool // Drop EXIF/ICC/XMP on save
 }
 
 // Init initializes the VIPS library. Must be called once before any other operations.
@@ -287,14 +291,16 @@ func (img *Image) ExtractMetadata() (ImageMeta, error) {
 	colorspace := C.GoString(&cMeta.colorspace[0])
 
 	meta := ImageMeta{
-		Width:      int(cMeta.width),
-		Height:     int(cMeta.height),
-		Channels:   int(cMeta.channels),
-		Format:     format,
-		Colorspace: colorspace,
-		DensityX:   float64(cMeta.density_x),
-		DensityY:   float64(cMeta.density_y),
-		FileSize:   int(cMeta.file_size), // Will be 0
+		Width:       int(cMeta.width),
+		Height:      int(cMeta.height),
+		Channels:    int(cMeta.channels),
+		Format:      format,
+		Colorspace:  colorspace,
+		DensityX:    float64(cMeta.density_x),
+		DensityY:    float64(cMeta.density_y),
+		FileSize:    int(cMeta.file_size), // Will be 0
+		Orientation: int(cMeta.orientation),
+		HasAlpha:    cMeta.has_alpha != 0,
 	}
 	return meta, nil
 }
@@ -308,10 +314,14 @@ func (img *Image) EncodeToJPEG(options *ImageEncodeJPEGOptions) ([]byte, error)
 	cOptions := C.ImageEncodeJPEGOptions{
 		quality:   C.int(options.Quality),
 		interlace: C.int(0),
+		strip:     C.int(0),
 	}
 	if options.Interlace {
 		cOptions.interlace = C.int(1)
 	}
+	if options.StripMetadata {
+		cOptions.strip = C.int(1)
+	}
 
 	cBuffer := C.encode_to_jpeg(img.handle, cOptions)
 	if cBuffer.data == nil {
@@ -333,10 +343,14 @@ func (img *Image) EncodeToPNG(options *ImageEncodePNGOptions) ([]byte, error) {
 	cOptions := C.ImageEncodePNGOptions{
 		compression: C.int(options.Compression),
 		interlace:   C.int(0),
+		strip:       C.int(0),
 	}
 	if options.Interlace {
 		cOptions.interlace = C.int(1)
 	}
+	if options.StripMetadata {
+		cOptions.strip = C.int(1)
+	}
 
 	cBuffer := C.encode_to_png(img.handle, cOptions)
 	if cBuffer.data == nil {